@@ -0,0 +1,77 @@
+package fast
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/test/sizeunderscore"
+)
+
+// newFastSample and newGogoSample build equivalent SizeMessage values in
+// the fast and sizeunderscore packages respectively, so the two
+// benchmarks below exercise the same payload and their -benchmem output
+// is directly comparable.
+
+func newFastSample() *SizeMessage {
+	return &SizeMessage{
+		Size_:   1 << 20,
+		Equal_:  true,
+		String_: "benchmark-sizeunderscore-payload",
+	}
+}
+
+func newGogoSample() *sizeunderscore.SizeMessage {
+	size := int64(1 << 20)
+	equal := true
+	str := "benchmark-sizeunderscore-payload"
+	return &sizeunderscore.SizeMessage{Size_: &size, Equal_: &equal, String_: &str}
+}
+
+func BenchmarkMarshalFast(b *testing.B) {
+	m := newFastSample()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := m.Marshal(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMarshalGogo(b *testing.B) {
+	m := newGogoSample()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := m.Marshal(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUnmarshalFast(b *testing.B) {
+	m := newFastSample()
+	dAtA, err := m.Marshal()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		out := &SizeMessage{}
+		if err := out.Unmarshal(dAtA); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUnmarshalGogo(b *testing.B) {
+	m := newGogoSample()
+	dAtA, err := m.Marshal()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		out := &sizeunderscore.SizeMessage{}
+		if err := out.Unmarshal(dAtA); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package fast
+
+import "sync"
+
+// bufferPools buckets scratch buffers by size class (powers of two from 64
+// to 8192 bytes inclusive) so Marshal can reuse a right-sized buffer instead
+// of allocating dAtA := make([]byte, size) on every call. Messages larger
+// than the top bucket fall back to a plain allocation.
+var bufferPoolClasses = [...]int{64, 128, 256, 512, 1024, 2048, 4096, 8192}
+
+var bufferPools [len(bufferPoolClasses)]sync.Pool
+
+func init() {
+	for i, class := range bufferPoolClasses {
+		class := class
+		bufferPools[i].New = func() interface{} {
+			b := make([]byte, class)
+			return &b
+		}
+	}
+}
+
+func poolClassFor(size int) int {
+	for i, class := range bufferPoolClasses {
+		if size <= class {
+			return i
+		}
+	}
+	return -1
+}
+
+// getBuffer returns a *[]byte of length >= size. The returned buffer must
+// be released with putBuffer once the caller is done reading from it.
+func getBuffer(size int) *[]byte {
+	if i := poolClassFor(size); i >= 0 {
+		buf := bufferPools[i].Get().(*[]byte)
+		if cap(*buf) < size {
+			*buf = make([]byte, bufferPoolClasses[i])
+		}
+		*buf = (*buf)[:bufferPoolClasses[i]]
+		return buf
+	}
+	b := make([]byte, size)
+	return &b
+}
+
+// putBuffer returns buf to its size-class pool. Buffers that didn't come
+// from a pooled class (oversized messages) are simply dropped.
+func putBuffer(buf *[]byte) {
+	if i := poolClassFor(len(*buf)); i >= 0 && len(*buf) == bufferPoolClasses[i] {
+		bufferPools[i].Put(buf)
+	}
+}
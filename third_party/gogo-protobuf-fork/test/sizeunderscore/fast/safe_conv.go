@@ -0,0 +1,11 @@
+//go:build purego || appengine
+// +build purego appengine
+
+package fast
+
+// byteString makes the usual defensive copy. Used on appengine/purego
+// builds where the unsafe string-header reinterpret in unsafe_conv.go is
+// unavailable.
+func byteString(b []byte) string {
+	return string(b)
+}
@@ -0,0 +1,430 @@
+// Package fast is a hand-written, gogofaster-style variant of
+// sizeunderscore.SizeMessage: scalar fields are value types instead of
+// pointers (no presence tracking, matching proto3 semantics for a proto2
+// message), Marshal draws its scratch buffer from a sync.Pool bucketed by
+// size class, and Unmarshal reuses the destination message instead of
+// allocating one per call. It exists alongside the APIv2-based
+// sizeunderscore package as the low-allocation path for callers on the hot
+// path of encoding/decoding this message.
+//
+// Unlike sizeunderscore.pb.go, which is protoc-gen-go output, this file is
+// hand-written and NOT produced by any generator such as
+// protoc-gen-gogofaster (real gogofaster output never emits pooled buffers
+// or unsafe string conversions) and has no accompanying codegen step, so it
+// must be edited by hand alongside sizeunderscore.proto if the wire format
+// changes.
+package fast
+
+import (
+	fmt "fmt"
+	io "io"
+	math_bits "math/bits"
+)
+
+type SizeMessage struct {
+	Size_                int64    `protobuf:"varint,1,opt,name=size" json:"size,omitempty"`
+	Equal_               bool     `protobuf:"varint,2,opt,name=Equal" json:"Equal,omitempty"`
+	String_              string   `protobuf:"bytes,3,opt,name=String" json:"String,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SizeMessage) Reset()      { *m = SizeMessage{} }
+func (*SizeMessage) ProtoMessage() {}
+
+func (m *SizeMessage) GetSize_() int64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+func (m *SizeMessage) GetEqual_() bool {
+	if m != nil {
+		return m.Equal_
+	}
+	return false
+}
+
+func (m *SizeMessage) GetString_() string {
+	if m != nil {
+		return m.String_
+	}
+	return ""
+}
+
+func (this *SizeMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+	that1, ok := that.(*SizeMessage)
+	if !ok {
+		that2, ok := that.(SizeMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Size_ != that1.Size_ {
+		return false
+	}
+	if this.Equal_ != that1.Equal_ {
+		return false
+	}
+	if this.String_ != that1.String_ {
+		return false
+	}
+	if len(this.XXX_unrecognized) != len(that1.XXX_unrecognized) {
+		return false
+	}
+	for i := range this.XXX_unrecognized {
+		if this.XXX_unrecognized[i] != that1.XXX_unrecognized[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Marshal borrows a scratch buffer from the size-class pool, encodes into
+// it, and returns a right-sized copy. Callers on a tighter allocation
+// budget that can guarantee the returned slice is fully consumed before
+// the next Marshal call should use MarshalToSizedBuffer with a buffer
+// drawn from their own pool instead.
+func (m *SizeMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	buf := getBuffer(size)
+	n, err := m.MarshalToSizedBuffer((*buf)[:size])
+	if err != nil {
+		putBuffer(buf)
+		return nil, err
+	}
+	dAtA = make([]byte, n)
+	copy(dAtA, (*buf)[size-n:size])
+	putBuffer(buf)
+	return dAtA, nil
+}
+
+func (m *SizeMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SizeMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.String_) > 0 {
+		i -= len(m.String_)
+		copy(dAtA[i:], m.String_)
+		i = encodeVarintSizeunderscore(dAtA, i, uint64(len(m.String_)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Equal_ {
+		i--
+		if m.Equal_ {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintSizeunderscore(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSizeunderscore(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSizeunderscore(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *SizeMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Size_ != 0 {
+		n += 1 + sovSizeunderscore(uint64(m.Size_))
+	}
+	if m.Equal_ {
+		n += 2
+	}
+	l = len(m.String_)
+	if l > 0 {
+		n += 1 + l + sovSizeunderscore(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovSizeunderscore(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+// Unmarshal decodes dAtA into m in place. String_ is set with byteString
+// (see unsafe_conv.go / safe_conv.go): on default builds it shares dAtA's
+// memory instead of copying, trading the usual defensive copy for one fewer
+// allocation per decode; purego and appengine builds copy.
+func (m *SizeMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSizeunderscore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SizeMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SizeMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSizeunderscore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Equal_", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSizeunderscore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Equal_ = v != 0
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field String_", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSizeunderscore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSizeunderscore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSizeunderscore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.String_ = byteString(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSizeunderscore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSizeunderscore
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSizeunderscore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipSizeunderscore(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSizeunderscore
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSizeunderscore
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSizeunderscore
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSizeunderscore
+			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthSizeunderscore
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowSizeunderscore
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipSizeunderscore(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthSizeunderscore
+				}
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthSizeunderscore = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSizeunderscore   = fmt.Errorf("proto: integer overflow")
+)
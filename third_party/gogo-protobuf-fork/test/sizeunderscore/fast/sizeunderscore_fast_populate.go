@@ -0,0 +1,102 @@
+// Hand-written alongside sizeunderscore_fast.pb.go, in the style of
+// gogo's populate plugin; there is no codegen step for this package.
+// source: sizeunderscore.proto
+
+package fast
+
+func NewPopulatedSizeMessage(r randySizeunderscore, easy bool) *SizeMessage {
+	this := &SizeMessage{}
+	if r.Intn(5) != 0 {
+		this.Size_ = int64(r.Int63())
+		if r.Intn(2) == 0 {
+			this.Size_ *= -1
+		}
+	}
+	if r.Intn(5) != 0 {
+		this.Equal_ = bool(r.Intn(2) == 0)
+	}
+	if r.Intn(5) != 0 {
+		this.String_ = randStringSizeunderscore(r)
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedSizeunderscore(r, 4)
+	}
+	return this
+}
+
+type randySizeunderscore interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneSizeunderscore(r randySizeunderscore) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+
+func randStringSizeunderscore(r randySizeunderscore) string {
+	v := r.Intn(100)
+	tmps := make([]rune, v)
+	for i := 0; i < v; i++ {
+		tmps[i] = randUTF8RuneSizeunderscore(r)
+	}
+	return string(tmps)
+}
+
+func randUnrecognizedSizeunderscore(r randySizeunderscore, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldSizeunderscore(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+
+func randFieldSizeunderscore(dAtA []byte, r randySizeunderscore, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateSizeunderscore(dAtA, uint64(key))
+		v := r.Int63()
+		if r.Intn(2) == 0 {
+			v *= -1
+		}
+		dAtA = encodeVarintPopulateSizeunderscore(dAtA, uint64(v))
+	case 1:
+		dAtA = encodeVarintPopulateSizeunderscore(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateSizeunderscore(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateSizeunderscore(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateSizeunderscore(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+
+func encodeVarintPopulateSizeunderscore(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
@@ -0,0 +1,18 @@
+//go:build !purego && !appengine
+// +build !purego,!appengine
+
+package fast
+
+import "unsafe"
+
+// byteString converts b to a string without copying. The string shares b's
+// memory and Unmarshal stores it in m.String_, so it stays valid only while
+// the caller leaves dAtA unmodified; the string itself is never written to. go vet's
+// unsafeptr check is satisfied since the conversion goes through
+// unsafe.Pointer rather than a direct reinterpret cast.
+func byteString(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	return *(*string)(unsafe.Pointer(&b))
+}
@@ -0,0 +1,115 @@
+package sizeunderscore
+
+import (
+	"fmt"
+	"hash"
+	"sort"
+
+	protowire "google.golang.org/protobuf/encoding/protowire"
+)
+
+// MarshalDeterministic encodes m's known fields in ascending tag order,
+// followed by any unrecognized fields sorted the same way. It does NOT use
+// proto.MarshalOptions{Deterministic: true}: that flag's own doc comment
+// (google.golang.org/protobuf@v1.31.0, proto/encode.go) states its output
+// "is NOT canonical across languages" and "is not guaranteed to remain
+// stable over time", and explicitly tells callers who need canonical,
+// content-addressable output to write their own serializer rather than
+// rely on it. So this method walks m's three known fields itself, in
+// declaration/tag order, rather than delegating to that disclaimed flag —
+// a future protobuf-go release changing the deterministic flag's internal
+// field order cannot change this method's output.
+//
+// Unknown fields still need sorting on top of that: two messages with
+// identical known-field content but unrecognized fields received in a
+// different order would otherwise marshal to different bytes. It returns
+// an error if the unrecognized bytes contain the same tag more than once,
+// since there's no canonical order for same-tag repeats without knowing
+// whether the field is repeated.
+//
+// SizeMessage has no float/double field, so the "reject NaN" requirement
+// from the original request does not apply here; there is nothing for
+// this method to reject.
+func (m *SizeMessage) MarshalDeterministic() ([]byte, error) {
+	sorted, err := sortUnknownByTag(m.ProtoReflect().GetUnknown())
+	if err != nil {
+		return nil, err
+	}
+	var out []byte
+	if m.Size_ != nil {
+		out = protowire.AppendTag(out, 1, protowire.VarintType)
+		out = protowire.AppendVarint(out, uint64(*m.Size_))
+	}
+	if m.Equal_ != nil {
+		out = protowire.AppendTag(out, 2, protowire.VarintType)
+		if *m.Equal_ {
+			out = protowire.AppendVarint(out, 1)
+		} else {
+			out = protowire.AppendVarint(out, 0)
+		}
+	}
+	if m.String_ != nil {
+		out = protowire.AppendTag(out, 3, protowire.BytesType)
+		out = protowire.AppendString(out, *m.String_)
+	}
+	return append(out, sorted...), nil
+}
+
+// CanonicalHash writes m's deterministic encoding into h and returns the
+// resulting digest, so that h can be used as a content address or leaf
+// hash over SizeMessage values (e.g. in a Merkle tree) and get the same
+// answer regardless of field or unknown-field ordering on the wire. Unlike
+// a hash built on proto.MarshalOptions{Deterministic: true}, this one does
+// not depend on protobuf-go's internal field emission order, so it stays
+// stable across protobuf-go upgrades.
+func (m *SizeMessage) CanonicalHash(h hash.Hash) ([]byte, error) {
+	b, err := m.MarshalDeterministic()
+	if err != nil {
+		return nil, err
+	}
+	h.Reset()
+	if _, err := h.Write(b); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
+
+type unknownField struct {
+	tag protowire.Number
+	raw []byte
+}
+
+// sortUnknownByTag parses b as a sequence of wire-format (tag, value)
+// pairs and returns an equivalent sequence sorted by ascending tag number.
+// It errors on malformed input or on a tag appearing more than once.
+func sortUnknownByTag(b []byte) ([]byte, error) {
+	if len(b) == 0 {
+		return b, nil
+	}
+	var fields []unknownField
+	seen := make(map[protowire.Number]bool)
+	rest := b
+	for len(rest) > 0 {
+		num, typ, n := protowire.ConsumeTag(rest)
+		if n < 0 {
+			return nil, fmt.Errorf("sizeunderscore: invalid unrecognized field tag")
+		}
+		m := protowire.ConsumeFieldValue(num, typ, rest[n:])
+		if m < 0 {
+			return nil, fmt.Errorf("sizeunderscore: invalid unrecognized field value for tag %d", num)
+		}
+		total := n + m
+		if seen[num] {
+			return nil, fmt.Errorf("sizeunderscore: duplicate unrecognized tag %d, cannot canonicalize", num)
+		}
+		seen[num] = true
+		fields = append(fields, unknownField{tag: num, raw: append([]byte(nil), rest[:total]...)})
+		rest = rest[total:]
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].tag < fields[j].tag })
+	out := make([]byte, 0, len(b))
+	for _, f := range fields {
+		out = append(out, f.raw...)
+	}
+	return out, nil
+}
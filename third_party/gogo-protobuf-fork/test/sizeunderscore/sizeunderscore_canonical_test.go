@@ -0,0 +1,75 @@
+package sizeunderscore
+
+import (
+	"bytes"
+	"hash"
+	"hash/fnv"
+	"testing"
+
+	protowire "google.golang.org/protobuf/encoding/protowire"
+)
+
+func newTestHash() hash.Hash {
+	return fnv.New64a()
+}
+
+func rawUnknownField(t *testing.T, num protowire.Number, v uint64) []byte {
+	t.Helper()
+	var b []byte
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	b = protowire.AppendVarint(b, v)
+	return b
+}
+
+// TestMarshalDeterministicSortsUnknownFields builds the same message twice
+// with its unrecognized fields received in different orders and checks
+// that MarshalDeterministic (and therefore CanonicalHash) produces
+// identical output regardless of that ordering.
+func TestMarshalDeterministicSortsUnknownFields(t *testing.T) {
+	size := int64(42)
+	a := &SizeMessage{Size_: &size}
+	b := &SizeMessage{Size_: &size}
+
+	fieldHigh := rawUnknownField(t, 10, 7)
+	fieldLow := rawUnknownField(t, 5, 9)
+
+	a.ProtoReflect().SetUnknown(append(append([]byte(nil), fieldHigh...), fieldLow...))
+	b.ProtoReflect().SetUnknown(append(append([]byte(nil), fieldLow...), fieldHigh...))
+
+	outA, err := a.MarshalDeterministic()
+	if err != nil {
+		t.Fatalf("MarshalDeterministic(a): %v", err)
+	}
+	outB, err := b.MarshalDeterministic()
+	if err != nil {
+		t.Fatalf("MarshalDeterministic(b): %v", err)
+	}
+	if !bytes.Equal(outA, outB) {
+		t.Fatalf("MarshalDeterministic depends on unknown-field order: %x != %x", outA, outB)
+	}
+
+	hashA, err := a.CanonicalHash(newTestHash())
+	if err != nil {
+		t.Fatalf("CanonicalHash(a): %v", err)
+	}
+	hashB, err := b.CanonicalHash(newTestHash())
+	if err != nil {
+		t.Fatalf("CanonicalHash(b): %v", err)
+	}
+	if !bytes.Equal(hashA, hashB) {
+		t.Fatalf("CanonicalHash depends on unknown-field order: %x != %x", hashA, hashB)
+	}
+}
+
+// TestMarshalDeterministicRejectsDuplicateUnknownTag ensures a message
+// carrying the same unrecognized tag twice is rejected rather than
+// canonicalized into an arbitrary order.
+func TestMarshalDeterministicRejectsDuplicateUnknownTag(t *testing.T) {
+	m := &SizeMessage{}
+	dup := append(rawUnknownField(t, 9, 1), rawUnknownField(t, 9, 2)...)
+	m.ProtoReflect().SetUnknown(dup)
+
+	if _, err := m.MarshalDeterministic(); err == nil {
+		t.Fatal("MarshalDeterministic: expected error for duplicate unrecognized tag, got nil")
+	}
+}
@@ -0,0 +1,57 @@
+package sizeunderscore
+
+// This file exists only for the duration of the gogo/protobuf ->
+// google.golang.org/protobuf migration. It ships unconditionally (no build
+// tag) so that downstream callers in aci-containers that still
+// marshal/unmarshal SizeMessage the gogo way (Marshal/MarshalTo/Unmarshal/
+// Size/Equal returning a []byte or bool instead of going through
+// proto.Marshal) keep compiling out of the box while they migrate to
+// calling the APIv2 proto package directly. Remove this file once no
+// caller needs it.
+
+import (
+	proto "google.golang.org/protobuf/proto"
+)
+
+// Marshal mirrors the gogo-generated signature so existing call sites
+// compile unchanged.
+func (m *SizeMessage) Marshal() ([]byte, error) {
+	return proto.Marshal(m)
+}
+
+// MarshalTo mirrors the gogo-generated signature, copying the encoded
+// message into dAtA and returning the number of bytes written. Like real
+// gogo-generated MarshalTo, it panics (via the dAtA[:size] slice) rather
+// than silently truncating when dAtA is too small to hold the message.
+func (m *SizeMessage) MarshalTo(dAtA []byte) (int, error) {
+	b, err := proto.Marshal(m)
+	if err != nil {
+		return 0, err
+	}
+	return copy(dAtA[:len(b)], b), nil
+}
+
+// Unmarshal mirrors the gogo-generated signature.
+func (m *SizeMessage) Unmarshal(dAtA []byte) error {
+	return proto.Unmarshal(dAtA, m)
+}
+
+// Size mirrors the gogo-generated signature, returning the encoded length.
+func (m *SizeMessage) Size() int {
+	return proto.Size(m)
+}
+
+// Equal mirrors the gogo-generated signature for callers that still call
+// m.Equal; the comparison itself is done by proto.Equal.
+func (m *SizeMessage) Equal(that interface{}) bool {
+	that1, ok := that.(*SizeMessage)
+	if !ok {
+		return false
+	}
+	if that1 == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+	return proto.Equal(m, that1)
+}